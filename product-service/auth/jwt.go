@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrInvalidToken is returned when a token is malformed, expired, or signed
+// with an unexpected method.
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+const defaultTokenTTL = 24 * time.Hour
+
+// Claims are the custom JWT claims stored for an authenticated user.
+type Claims struct {
+	UserID   int    `json:"user_id"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+func tokenSecret() []byte {
+	secret := os.Getenv("TOKEN_SECRET")
+	if secret == "" {
+		secret = "dev-secret-change-me"
+	}
+	return []byte(secret)
+}
+
+// GenerateToken issues an HS256-signed JWT for the given user.
+func GenerateToken(userID int, username, role string) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserID:   userID,
+		Username: username,
+		Role:     role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(defaultTokenTTL)),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(tokenSecret())
+}
+
+// ValidateToken parses and verifies a JWT, returning its claims on success.
+func ValidateToken(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrInvalidToken
+		}
+		return tokenSecret(), nil
+	})
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if !token.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestGenerateAndValidateToken(t *testing.T) {
+	token, err := GenerateToken(1, "alice", "admin")
+	if err != nil {
+		t.Fatalf("GenerateToken returned error: %v", err)
+	}
+
+	claims, err := ValidateToken(token)
+	if err != nil {
+		t.Fatalf("ValidateToken returned error: %v", err)
+	}
+
+	if claims.UserID != 1 || claims.Username != "alice" || claims.Role != "admin" {
+		t.Fatalf("unexpected claims: %+v", claims)
+	}
+}
+
+func TestValidateTokenRejectsInvalidToken(t *testing.T) {
+	if _, err := ValidateToken("not-a-jwt"); err != ErrInvalidToken {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+}
+
+func TestValidateTokenRejectsExpiredToken(t *testing.T) {
+	now := time.Now()
+	claims := Claims{
+		UserID:   1,
+		Username: "alice",
+		Role:     "admin",
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now.Add(-2 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(now.Add(-time.Hour)),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(tokenSecret())
+	if err != nil {
+		t.Fatalf("failed to sign test token: %v", err)
+	}
+
+	if _, err := ValidateToken(token); err != ErrInvalidToken {
+		t.Fatalf("expected ErrInvalidToken for expired token, got %v", err)
+	}
+}
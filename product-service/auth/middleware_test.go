@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/protected", RequireAuth(), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+	router.GET("/admin", RequireAuth(), RequireRole("admin"), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+	return router
+}
+
+func performRequest(router *gin.Engine, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestRequireAuthMissingToken(t *testing.T) {
+	router := newTestRouter()
+	w := performRequest(router, "/protected", "")
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", w.Code)
+	}
+}
+
+func TestRequireAuthInvalidToken(t *testing.T) {
+	router := newTestRouter()
+	w := performRequest(router, "/protected", "garbage")
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", w.Code)
+	}
+}
+
+func TestRequireAuthValidToken(t *testing.T) {
+	router := newTestRouter()
+	token, err := GenerateToken(1, "alice", "customer")
+	if err != nil {
+		t.Fatalf("GenerateToken returned error: %v", err)
+	}
+
+	w := performRequest(router, "/protected", token)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+}
+
+func TestRequireRoleRejectsWrongRole(t *testing.T) {
+	router := newTestRouter()
+	token, err := GenerateToken(1, "alice", "customer")
+	if err != nil {
+		t.Fatalf("GenerateToken returned error: %v", err)
+	}
+
+	w := performRequest(router, "/admin", token)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", w.Code)
+	}
+}
+
+func TestRequireRoleAllowsMatchingRole(t *testing.T) {
+	router := newTestRouter()
+	token, err := GenerateToken(1, "bob", "admin")
+	if err != nil {
+		t.Fatalf("GenerateToken returned error: %v", err)
+	}
+
+	w := performRequest(router, "/admin", token)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+}
@@ -0,0 +1,50 @@
+// Package config centralizes environment-variable driven configuration for
+// the product service.
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+// Config holds the settings the service needs to boot.
+type Config struct {
+	Port          string
+	GRPCPort      string
+	DBHost        string
+	DBPort        string
+	DBName        string
+	DBUser        string
+	DBPassword    string
+	AdminUsername string
+	AdminPassword string
+}
+
+// Load reads configuration from the environment, falling back to
+// development defaults when a variable is unset.
+func Load() Config {
+	return Config{
+		Port:          getEnv("PORT", "8080"),
+		GRPCPort:      getEnv("GRPC_PORT", "9090"),
+		DBHost:        getEnv("DB_HOST", "localhost"),
+		DBPort:        getEnv("DB_PORT", "5432"),
+		DBName:        getEnv("DB_NAME", "productdb"),
+		DBUser:        getEnv("DB_USER", "postgres"),
+		DBPassword:    getEnv("DB_PASSWORD", "password"),
+		AdminUsername: getEnv("ADMIN_USERNAME", ""),
+		AdminPassword: getEnv("ADMIN_PASSWORD", ""),
+	}
+}
+
+// DSN builds the Postgres connection string for this config.
+func (c Config) DSN() string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		c.DBHost, c.DBPort, c.DBUser, c.DBPassword, c.DBName)
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
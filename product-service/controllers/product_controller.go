@@ -0,0 +1,259 @@
+// Package controllers holds the Gin HTTP handlers, kept thin by delegating
+// all persistence to the repository layer.
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/gin-gonic/gin"
+
+	"product-service/models"
+	"product-service/observability"
+	"product-service/repository"
+)
+
+const (
+	mergePatchContentType = "application/merge-patch+json"
+	jsonPatchContentType  = "application/json-patch+json"
+)
+
+// ProductController exposes HTTP handlers for the products resource.
+type ProductController struct {
+	repo repository.ProductRepository
+}
+
+// NewProductController wires a ProductController to the given repository.
+func NewProductController(repo repository.ProductRepository) *ProductController {
+	return &ProductController{repo: repo}
+}
+
+func (pc *ProductController) List(c *gin.Context) {
+	params, err := parseProductListParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	products, total, err := pc.repo.List(c.Request.Context(), params)
+	if err != nil {
+		log.Printf("Error querying products: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch products"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"products": products,
+		"total":    total,
+		"limit":    params.Limit,
+		"offset":   params.Offset,
+	})
+}
+
+func (pc *ProductController) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product id"})
+		return
+	}
+
+	product, err := pc.repo.Get(c.Request.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+		return
+	} else if err != nil {
+		log.Printf("Error querying product: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch product"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"product": product})
+}
+
+func (pc *ProductController) Create(c *gin.Context) {
+	var req models.CreateProductRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	product, err := pc.repo.Create(c.Request.Context(), req)
+	if err != nil {
+		log.Printf("Error creating product: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create product"})
+		return
+	}
+	observability.IncProductsCreated()
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Product created successfully",
+		"product": product,
+	})
+}
+
+func (pc *ProductController) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product id"})
+		return
+	}
+
+	var req models.UpdateProductRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	product, err := pc.repo.Update(c.Request.Context(), id, req)
+	if errors.Is(err, repository.ErrNoFieldsToUpdate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No fields to update"})
+		return
+	} else if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+		return
+	} else if err != nil {
+		log.Printf("Error updating product: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update product"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Product updated successfully",
+		"product": product,
+	})
+}
+
+// Patch handles PATCH /api/products/:id. It accepts a JSON Merge Patch
+// (RFC 7396, application/merge-patch+json) or a JSON Patch (RFC 6902,
+// application/json-patch+json) document, applies it to the current product,
+// and persists the result with optimistic concurrency: if the product was
+// modified since it was read, the request fails with 409 Conflict.
+func (pc *ProductController) Patch(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product id"})
+		return
+	}
+
+	contentType := c.ContentType()
+	if contentType != mergePatchContentType && contentType != jsonPatchContentType {
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content-Type must be application/merge-patch+json or application/json-patch+json"})
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
+		return
+	}
+
+	current, err := pc.repo.Get(c.Request.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+		return
+	} else if err != nil {
+		log.Printf("Error querying product: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch product"})
+		return
+	}
+
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode current product"})
+		return
+	}
+
+	var patchedJSON []byte
+	if contentType == mergePatchContentType {
+		patchedJSON, err = jsonpatch.MergePatch(currentJSON, body)
+	} else {
+		var patch jsonpatch.Patch
+		patch, err = jsonpatch.DecodePatch(body)
+		if err == nil {
+			patchedJSON, err = patch.Apply(currentJSON)
+		}
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid patch document: " + err.Error()})
+		return
+	}
+
+	var patched models.Product
+	if err := json.Unmarshal(patchedJSON, &patched); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Patch result is not a valid product"})
+		return
+	}
+
+	if patched.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "name must not be empty"})
+		return
+	}
+	if patched.Description == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "description must not be empty"})
+		return
+	}
+	if patched.Category == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "category must not be empty"})
+		return
+	}
+	if patched.Price <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "price must be greater than 0"})
+		return
+	}
+	if patched.Stock < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "stock must be greater than or equal to 0"})
+		return
+	}
+
+	req := models.UpdateProductRequest{
+		Name:        &patched.Name,
+		Description: &patched.Description,
+		Price:       &patched.Price,
+		Stock:       &patched.Stock,
+		Category:    &patched.Category,
+	}
+
+	product, err := pc.repo.Patch(c.Request.Context(), id, current.Version, req)
+	if errors.Is(err, repository.ErrVersionConflict) {
+		c.JSON(http.StatusConflict, gin.H{"error": "Product was modified concurrently, please retry"})
+		return
+	} else if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+		return
+	} else if err != nil {
+		log.Printf("Error patching product: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update product"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Product updated successfully",
+		"product": product,
+	})
+}
+
+func (pc *ProductController) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product id"})
+		return
+	}
+
+	err = pc.repo.Delete(c.Request.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+		return
+	} else if err != nil {
+		log.Printf("Error deleting product: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete product"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Product deleted successfully"})
+}
@@ -0,0 +1,243 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"product-service/models"
+	"product-service/repository"
+)
+
+type mockProductRepository struct {
+	products map[int]models.Product
+	// forcePatchConflict, when true, makes Patch always report a version
+	// conflict regardless of expectedVersion, simulating a concurrent write
+	// that happened between the controller's read and its patch call.
+	forcePatchConflict bool
+}
+
+func (m *mockProductRepository) List(ctx context.Context, params models.ProductListParams) ([]models.Product, int, error) {
+	products := []models.Product{}
+	for _, p := range m.products {
+		products = append(products, p)
+	}
+	return products, len(products), nil
+}
+
+func (m *mockProductRepository) Get(ctx context.Context, id int) (*models.Product, error) {
+	product, ok := m.products[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &product, nil
+}
+
+func (m *mockProductRepository) Create(ctx context.Context, req models.CreateProductRequest) (*models.Product, error) {
+	product := models.Product{ID: len(m.products) + 1, Name: req.Name, Description: req.Description, Price: req.Price, Stock: req.Stock, Category: req.Category}
+	m.products[product.ID] = product
+	return &product, nil
+}
+
+func (m *mockProductRepository) Update(ctx context.Context, id int, req models.UpdateProductRequest) (*models.Product, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (m *mockProductRepository) Patch(ctx context.Context, id int, expectedVersion int, req models.UpdateProductRequest) (*models.Product, error) {
+	if m.forcePatchConflict {
+		return nil, repository.ErrVersionConflict
+	}
+
+	product, ok := m.products[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	if product.Version != expectedVersion {
+		return nil, repository.ErrVersionConflict
+	}
+
+	if req.Name != nil {
+		product.Name = *req.Name
+	}
+	if req.Description != nil {
+		product.Description = *req.Description
+	}
+	if req.Price != nil {
+		product.Price = *req.Price
+	}
+	if req.Stock != nil {
+		product.Stock = *req.Stock
+	}
+	if req.Category != nil {
+		product.Category = *req.Category
+	}
+	product.Version++
+
+	m.products[id] = product
+	return &product, nil
+}
+
+func (m *mockProductRepository) Delete(ctx context.Context, id int) error {
+	return sql.ErrNoRows
+}
+
+func newTestProductController() (*ProductController, *mockProductRepository) {
+	gin.SetMode(gin.TestMode)
+	repo := &mockProductRepository{products: map[int]models.Product{
+		1: {ID: 1, Name: "Widget", Description: "A widget", Price: 9.99, Stock: 5, Category: "tools", Version: 1},
+	}}
+	return NewProductController(repo), repo
+}
+
+func TestProductControllerGetNotFound(t *testing.T) {
+	pc, _ := newTestProductController()
+
+	router := gin.New()
+	router.GET("/api/products/:id", pc.Get)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/products/999", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", w.Code)
+	}
+}
+
+func TestProductControllerGetFound(t *testing.T) {
+	pc, _ := newTestProductController()
+
+	router := gin.New()
+	router.GET("/api/products/:id", pc.Get)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/products/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+}
+
+func TestProductControllerGetInvalidID(t *testing.T) {
+	pc, _ := newTestProductController()
+
+	router := gin.New()
+	router.GET("/api/products/:id", pc.Get)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/products/abc", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", w.Code)
+	}
+}
+
+func TestProductControllerPatchMergePatch(t *testing.T) {
+	pc, _ := newTestProductController()
+
+	router := gin.New()
+	router.PATCH("/api/products/:id", pc.Patch)
+
+	body := bytes.NewBufferString(`{"stock": 0}`)
+	req := httptest.NewRequest(http.MethodPatch, "/api/products/1", body)
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestProductControllerPatchVersionConflict(t *testing.T) {
+	pc, repo := newTestProductController()
+	repo.forcePatchConflict = true
+
+	router := gin.New()
+	router.PATCH("/api/products/:id", pc.Patch)
+
+	body := bytes.NewBufferString(`{"stock": 1}`)
+	req := httptest.NewRequest(http.MethodPatch, "/api/products/1", body)
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected 409, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestProductControllerPatchMergePatchNullRequiredFieldRejected(t *testing.T) {
+	pc, _ := newTestProductController()
+
+	router := gin.New()
+	router.PATCH("/api/products/:id", pc.Patch)
+
+	body := bytes.NewBufferString(`{"name": null}`)
+	req := httptest.NewRequest(http.MethodPatch, "/api/products/1", body)
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestProductControllerPatchJSONPatch(t *testing.T) {
+	pc, _ := newTestProductController()
+
+	router := gin.New()
+	router.PATCH("/api/products/:id", pc.Patch)
+
+	body := bytes.NewBufferString(`[{"op": "replace", "path": "/stock", "value": 42}]`)
+	req := httptest.NewRequest(http.MethodPatch, "/api/products/1", body)
+	req.Header.Set("Content-Type", "application/json-patch+json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestProductControllerPatchJSONPatchRemoveRequiredFieldRejected(t *testing.T) {
+	pc, _ := newTestProductController()
+
+	router := gin.New()
+	router.PATCH("/api/products/:id", pc.Patch)
+
+	body := bytes.NewBufferString(`[{"op": "remove", "path": "/category"}]`)
+	req := httptest.NewRequest(http.MethodPatch, "/api/products/1", body)
+	req.Header.Set("Content-Type", "application/json-patch+json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestProductControllerPatchUnsupportedContentType(t *testing.T) {
+	pc, _ := newTestProductController()
+
+	router := gin.New()
+	router.PATCH("/api/products/:id", pc.Patch)
+
+	body := bytes.NewBufferString(`{"stock": 1}`)
+	req := httptest.NewRequest(http.MethodPatch, "/api/products/1", body)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnsupportedMediaType {
+		t.Fatalf("expected 415, got %d: %s", w.Code, w.Body.String())
+	}
+}
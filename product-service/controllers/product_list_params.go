@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"product-service/models"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+var allowedSortColumns = map[string]bool{
+	"name":       true,
+	"price":      true,
+	"created_at": true,
+	"stock":      true,
+}
+
+// parseProductListParams reads and validates the filtering, sorting, and
+// pagination query params accepted by GET /api/products.
+func parseProductListParams(c *gin.Context) (models.ProductListParams, error) {
+	params := models.ProductListParams{
+		Limit:      defaultLimit,
+		SortColumn: "created_at",
+		SortOrder:  "desc",
+	}
+
+	if pageSize := c.Query("page_size"); pageSize != "" {
+		size, err := strconv.Atoi(pageSize)
+		if err != nil || size < 1 {
+			return params, fmt.Errorf("invalid page_size: %s", pageSize)
+		}
+		params.Limit = size
+
+		page := 1
+		if raw := c.Query("page"); raw != "" {
+			page, err = strconv.Atoi(raw)
+			if err != nil || page < 1 {
+				return params, fmt.Errorf("invalid page: %s", raw)
+			}
+		}
+		params.Offset = (page - 1) * size
+	} else {
+		if limit := c.Query("limit"); limit != "" {
+			value, err := strconv.Atoi(limit)
+			if err != nil || value < 1 {
+				return params, fmt.Errorf("invalid limit: %s", limit)
+			}
+			params.Limit = value
+		}
+		if offset := c.Query("offset"); offset != "" {
+			value, err := strconv.Atoi(offset)
+			if err != nil || value < 0 {
+				return params, fmt.Errorf("invalid offset: %s", offset)
+			}
+			params.Offset = value
+		}
+	}
+
+	if params.Limit > maxLimit {
+		params.Limit = maxLimit
+	}
+
+	if sortColumn := c.Query("sort_column"); sortColumn != "" {
+		if !allowedSortColumns[sortColumn] {
+			return params, fmt.Errorf("invalid sort_column: %s", sortColumn)
+		}
+		params.SortColumn = sortColumn
+	}
+
+	if sortOrder := strings.ToLower(c.Query("sort_order")); sortOrder != "" {
+		if sortOrder != "asc" && sortOrder != "desc" {
+			return params, fmt.Errorf("invalid sort_order: %s", sortOrder)
+		}
+		params.SortOrder = sortOrder
+	}
+
+	params.Category = c.Query("category")
+	params.Query = c.Query("q")
+
+	if minPrice := c.Query("min_price"); minPrice != "" {
+		value, err := strconv.ParseFloat(minPrice, 64)
+		if err != nil {
+			return params, fmt.Errorf("invalid min_price: %s", minPrice)
+		}
+		params.MinPrice = &value
+	}
+
+	if maxPrice := c.Query("max_price"); maxPrice != "" {
+		value, err := strconv.ParseFloat(maxPrice, 64)
+		if err != nil {
+			return params, fmt.Errorf("invalid max_price: %s", maxPrice)
+		}
+		params.MaxPrice = &value
+	}
+
+	if inStock := c.Query("in_stock"); inStock != "" {
+		value, err := strconv.ParseBool(inStock)
+		if err != nil {
+			return params, fmt.Errorf("invalid in_stock: %s", inStock)
+		}
+		params.InStock = &value
+	}
+
+	return params, nil
+}
@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newListParamsContext(rawQuery string) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	req := httptest.NewRequest(http.MethodGet, "/api/products?"+rawQuery, nil)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = req
+	return c
+}
+
+func TestParseProductListParamsDefaults(t *testing.T) {
+	params, err := parseProductListParams(newListParamsContext(""))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if params.Limit != defaultLimit || params.SortColumn != "created_at" || params.SortOrder != "desc" {
+		t.Fatalf("unexpected defaults: %+v", params)
+	}
+}
+
+func TestParseProductListParamsRejectsInvalidSortColumn(t *testing.T) {
+	if _, err := parseProductListParams(newListParamsContext("sort_column=drop_table")); err == nil {
+		t.Fatal("expected error for invalid sort_column")
+	}
+}
+
+func TestParseProductListParamsCapsLimit(t *testing.T) {
+	params, err := parseProductListParams(newListParamsContext("limit=500"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if params.Limit != maxLimit {
+		t.Fatalf("expected limit capped at %d, got %d", maxLimit, params.Limit)
+	}
+}
+
+func TestParseProductListParamsPageSize(t *testing.T) {
+	params, err := parseProductListParams(newListParamsContext("page=2&page_size=10"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if params.Limit != 10 || params.Offset != 10 {
+		t.Fatalf("expected limit=10 offset=10, got limit=%d offset=%d", params.Limit, params.Offset)
+	}
+}
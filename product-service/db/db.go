@@ -0,0 +1,24 @@
+// Package db manages the service's database connection.
+package db
+
+import (
+	"database/sql"
+
+	_ "github.com/lib/pq"
+
+	"product-service/config"
+)
+
+// Connect opens a Postgres connection pool and verifies it with a ping.
+func Connect(cfg config.Config) (*sql.DB, error) {
+	database, err := sql.Open("postgres", cfg.DSN())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := database.Ping(); err != nil {
+		return nil, err
+	}
+
+	return database, nil
+}
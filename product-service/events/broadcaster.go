@@ -0,0 +1,50 @@
+package events
+
+import "sync"
+
+// Broadcaster fans out delivered events to in-process subscribers, such as
+// the gRPC WatchProducts stream. It is independent of the configured
+// EventPublisher, which targets external consumers.
+type Broadcaster struct {
+	mu   sync.Mutex
+	subs map[chan ProductEvent]struct{}
+}
+
+// NewBroadcaster returns an empty Broadcaster.
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{subs: make(map[chan ProductEvent]struct{})}
+}
+
+// Subscribe registers a new listener. Call the returned function to
+// unsubscribe and release its channel.
+func (b *Broadcaster) Subscribe() (<-chan ProductEvent, func()) {
+	ch := make(chan ProductEvent, 16)
+
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subs[ch]; ok {
+			delete(b.subs, ch)
+			close(ch)
+		}
+	}
+	return ch, unsubscribe
+}
+
+// Publish fans the event out to every current subscriber, dropping it for
+// any subscriber whose buffer is full rather than blocking.
+func (b *Broadcaster) Publish(event ProductEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Package events publishes product lifecycle events to a message broker
+// using the transactional outbox pattern: mutations write to the outbox
+// table inside the same DB transaction, and a Relay drains it asynchronously.
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-service/models"
+)
+
+// SchemaVersion is bumped whenever the ProductEvent payload shape changes.
+const SchemaVersion = 1
+
+// Event types emitted for product lifecycle changes.
+const (
+	ProductCreated      = "product.created"
+	ProductUpdated      = "product.updated"
+	ProductDeleted      = "product.deleted"
+	ProductStockChanged = "product.stock_changed"
+)
+
+// ProductEvent is the schema-versioned JSON payload stored in the outbox
+// and delivered to the configured broker.
+type ProductEvent struct {
+	SchemaVersion int            `json:"schema_version"`
+	EventID       string         `json:"event_id"`
+	EventType     string         `json:"event_type"`
+	OccurredAt    time.Time      `json:"occurred_at"`
+	Product       models.Product `json:"product"`
+}
+
+// NewProductEvent builds a ProductEvent snapshotting the given product.
+func NewProductEvent(eventType string, product models.Product) ProductEvent {
+	return ProductEvent{
+		SchemaVersion: SchemaVersion,
+		EventID:       uuid.NewString(),
+		EventType:     eventType,
+		OccurredAt:    time.Now(),
+		Product:       product,
+	}
+}
@@ -0,0 +1,23 @@
+package events
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// NewPublisherFromEnv builds the EventPublisher selected by EVENT_BROKER
+// (kafka, rabbitmq, or noop — the default when unset).
+func NewPublisherFromEnv() (EventPublisher, error) {
+	switch strings.ToLower(os.Getenv("EVENT_BROKER")) {
+	case "kafka":
+		brokers := strings.Split(os.Getenv("KAFKA_BROKERS"), ",")
+		return NewKafkaPublisher(brokers), nil
+	case "rabbitmq":
+		return NewRabbitMQPublisher(os.Getenv("RABBITMQ_URL"))
+	case "noop", "":
+		return NewNoopPublisher(), nil
+	default:
+		return nil, fmt.Errorf("unknown EVENT_BROKER: %s", os.Getenv("EVENT_BROKER"))
+	}
+}
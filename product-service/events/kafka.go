@@ -0,0 +1,34 @@
+package events
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type kafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaPublisher returns a publisher that writes to the given Kafka
+// brokers, one topic per event type.
+func NewKafkaPublisher(brokers []string) EventPublisher {
+	return &kafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:     kafka.TCP(brokers...),
+			Balancer: &kafka.LeastBytes{},
+		},
+	}
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, topic, key string, payload []byte) error {
+	return p.writer.WriteMessages(ctx, kafka.Message{
+		Topic: topic,
+		Key:   []byte(key),
+		Value: payload,
+	})
+}
+
+func (p *kafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,23 @@
+package events
+
+import (
+	"context"
+	"log"
+)
+
+type noopPublisher struct{}
+
+// NewNoopPublisher returns a publisher that only logs events, useful for
+// local development when no broker is configured.
+func NewNoopPublisher() EventPublisher {
+	return &noopPublisher{}
+}
+
+func (p *noopPublisher) Publish(ctx context.Context, topic, key string, payload []byte) error {
+	log.Printf("[events:noop] %s: %s", topic, payload)
+	return nil
+}
+
+func (p *noopPublisher) Close() error {
+	return nil
+}
@@ -0,0 +1,77 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// OutboxRecord is a row in the outbox table awaiting delivery.
+type OutboxRecord struct {
+	ID        int64
+	EventType string
+	Payload   json.RawMessage
+	Attempts  int
+	CreatedAt time.Time
+}
+
+// OutboxRepository persists outbox rows transactionally alongside product
+// mutations and lets the Relay drain them for delivery.
+type OutboxRepository interface {
+	Enqueue(ctx context.Context, tx *sql.Tx, eventType string, payload []byte) error
+	FetchPending(ctx context.Context, limit int) ([]OutboxRecord, error)
+	MarkPublished(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64) error
+}
+
+type postgresOutboxRepository struct {
+	db *sql.DB
+}
+
+// NewOutboxRepository returns an OutboxRepository backed by Postgres.
+func NewOutboxRepository(db *sql.DB) OutboxRepository {
+	return &postgresOutboxRepository{db: db}
+}
+
+func (r *postgresOutboxRepository) Enqueue(ctx context.Context, tx *sql.Tx, eventType string, payload []byte) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO outbox (event_type, payload, created_at)
+		VALUES ($1, $2, NOW())
+	`, eventType, payload)
+	return err
+}
+
+func (r *postgresOutboxRepository) FetchPending(ctx context.Context, limit int) ([]OutboxRecord, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, event_type, payload, attempts, created_at
+		FROM outbox
+		WHERE published_at IS NULL
+		ORDER BY created_at ASC
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := []OutboxRecord{}
+	for rows.Next() {
+		var rec OutboxRecord
+		if err := rows.Scan(&rec.ID, &rec.EventType, &rec.Payload, &rec.Attempts, &rec.CreatedAt); err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	return records, rows.Err()
+}
+
+func (r *postgresOutboxRepository) MarkPublished(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE outbox SET published_at = NOW() WHERE id = $1`, id)
+	return err
+}
+
+func (r *postgresOutboxRepository) MarkFailed(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE outbox SET attempts = attempts + 1 WHERE id = $1`, id)
+	return err
+}
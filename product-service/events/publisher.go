@@ -0,0 +1,11 @@
+package events
+
+import "context"
+
+// EventPublisher abstracts the message broker used to deliver events so the
+// outbox relay doesn't care whether it's talking to Kafka, RabbitMQ, or
+// nothing at all.
+type EventPublisher interface {
+	Publish(ctx context.Context, topic string, key string, payload []byte) error
+	Close() error
+}
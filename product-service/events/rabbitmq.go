@@ -0,0 +1,51 @@
+package events
+
+import (
+	"context"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const rabbitExchange = "product_events"
+
+type rabbitmqPublisher struct {
+	conn    *amqp.Connection
+	channel *amqp.Channel
+}
+
+// NewRabbitMQPublisher dials the given AMQP URL and declares the topic
+// exchange product events are published to.
+func NewRabbitMQPublisher(url string) (EventPublisher, error) {
+	conn, err := amqp.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if err := channel.ExchangeDeclare(rabbitExchange, "topic", true, false, false, false, nil); err != nil {
+		channel.Close()
+		conn.Close()
+		return nil, err
+	}
+
+	return &rabbitmqPublisher{conn: conn, channel: channel}, nil
+}
+
+func (p *rabbitmqPublisher) Publish(ctx context.Context, topic, key string, payload []byte) error {
+	return p.channel.PublishWithContext(ctx, rabbitExchange, topic, false, false, amqp.Publishing{
+		ContentType: "application/json",
+		Body:        payload,
+	})
+}
+
+func (p *rabbitmqPublisher) Close() error {
+	if err := p.channel.Close(); err != nil {
+		return err
+	}
+	return p.conn.Close()
+}
@@ -0,0 +1,91 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+)
+
+const (
+	relayBatchSize    = 20
+	relayPollInterval = 2 * time.Second
+	relayMaxBackoff   = 30 * time.Second
+)
+
+// Relay drains pending outbox rows to the configured EventPublisher,
+// delivering at-least-once and backing off exponentially on failure. If a
+// Broadcaster is set, each delivered event is also fanned out in-process
+// (used to feed the gRPC WatchProducts stream).
+type Relay struct {
+	outbox      OutboxRepository
+	publisher   EventPublisher
+	broadcaster *Broadcaster
+	backoff     time.Duration
+}
+
+// NewRelay builds a Relay over the given outbox and publisher. broadcaster
+// may be nil if no in-process subscribers need feeding.
+func NewRelay(outbox OutboxRepository, publisher EventPublisher, broadcaster *Broadcaster) *Relay {
+	return &Relay{outbox: outbox, publisher: publisher, broadcaster: broadcaster, backoff: relayPollInterval}
+}
+
+// Run polls the outbox until ctx is cancelled. Intended to run in its own
+// goroutine.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(relayPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.drain(ctx)
+		}
+	}
+}
+
+func (r *Relay) drain(ctx context.Context) {
+	records, err := r.outbox.FetchPending(ctx, relayBatchSize)
+	if err != nil {
+		log.Printf("[events] failed to fetch pending outbox records: %v", err)
+		return
+	}
+
+	for _, rec := range records {
+		if err := r.publisher.Publish(ctx, rec.EventType, "", rec.Payload); err != nil {
+			log.Printf("[events] failed to publish outbox record %d: %v", rec.ID, err)
+			if markErr := r.outbox.MarkFailed(ctx, rec.ID); markErr != nil {
+				log.Printf("[events] failed to mark outbox record %d failed: %v", rec.ID, markErr)
+			}
+			r.sleepBackoff(ctx)
+			continue
+		}
+
+		if err := r.outbox.MarkPublished(ctx, rec.ID); err != nil {
+			log.Printf("[events] failed to mark outbox record %d published: %v", rec.ID, err)
+		}
+		r.backoff = relayPollInterval
+
+		if r.broadcaster != nil {
+			var event ProductEvent
+			if err := json.Unmarshal(rec.Payload, &event); err != nil {
+				log.Printf("[events] failed to unmarshal outbox record %d for broadcast: %v", rec.ID, err)
+				continue
+			}
+			r.broadcaster.Publish(event)
+		}
+	}
+}
+
+func (r *Relay) sleepBackoff(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(r.backoff):
+	}
+	r.backoff *= 2
+	if r.backoff > relayMaxBackoff {
+		r.backoff = relayMaxBackoff
+	}
+}
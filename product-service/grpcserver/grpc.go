@@ -0,0 +1,31 @@
+package grpcserver
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"product-service/events"
+	pb "product-service/proto"
+	"product-service/repository"
+)
+
+// New builds the gRPC server: interceptors, the product service, health
+// checking, and reflection.
+func New(repo repository.ProductRepository, broadcaster *events.Broadcaster) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(RecoveryInterceptor, LoggingInterceptor, AuthInterceptor),
+		grpc.ChainStreamInterceptor(RecoveryStreamInterceptor, LoggingStreamInterceptor),
+	)
+
+	pb.RegisterProductServiceServer(server, NewProductServer(repo, broadcaster))
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	reflection.Register(server)
+
+	return server
+}
@@ -0,0 +1,86 @@
+package grpcserver
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"product-service/auth"
+)
+
+// publicMethods mirrors the REST convention that reads are public while
+// mutations require an admin-role JWT.
+var publicMethods = map[string]bool{
+	"/product.v1.ProductService/GetProduct":   true,
+	"/product.v1.ProductService/ListProducts": true,
+}
+
+// LoggingInterceptor logs each unary RPC's method, duration, and outcome.
+func LoggingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	log.Printf("[grpc] %s took %s, err=%v", info.FullMethod, time.Since(start), err)
+	return resp, err
+}
+
+// RecoveryInterceptor converts a handler panic into an Internal status
+// instead of crashing the server.
+func RecoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[grpc] panic in %s: %v", info.FullMethod, r)
+			err = status.Errorf(codes.Internal, "internal error")
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// AuthInterceptor mirrors auth.RequireAuth/RequireRole("admin"): mutating
+// RPCs must carry a valid admin JWT in the "authorization" metadata.
+func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if publicMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("authorization")) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization metadata")
+	}
+
+	token := strings.TrimPrefix(md.Get("authorization")[0], "Bearer ")
+	claims, err := auth.ValidateToken(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if claims.Role != "admin" {
+		return nil, status.Error(codes.PermissionDenied, "insufficient permissions")
+	}
+
+	return handler(ctx, req)
+}
+
+// LoggingStreamInterceptor is the streaming-RPC counterpart of LoggingInterceptor.
+func LoggingStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	log.Printf("[grpc] %s took %s, err=%v", info.FullMethod, time.Since(start), err)
+	return err
+}
+
+// RecoveryStreamInterceptor is the streaming-RPC counterpart of RecoveryInterceptor.
+func RecoveryStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[grpc] panic in %s: %v", info.FullMethod, r)
+			err = status.Errorf(codes.Internal, "internal error")
+		}
+	}()
+	return handler(srv, ss)
+}
@@ -0,0 +1,149 @@
+// Package grpcserver exposes product operations over gRPC on a separate
+// port, backed by the same ProductRepository used by the REST handlers.
+package grpcserver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"product-service/events"
+	"product-service/models"
+	pb "product-service/proto"
+	"product-service/repository"
+)
+
+// ProductServer implements pb.ProductServiceServer.
+type ProductServer struct {
+	pb.UnimplementedProductServiceServer
+	repo        repository.ProductRepository
+	broadcaster *events.Broadcaster
+}
+
+// NewProductServer wires a ProductServer to the given repository and
+// in-process event broadcaster.
+func NewProductServer(repo repository.ProductRepository, broadcaster *events.Broadcaster) *ProductServer {
+	return &ProductServer{repo: repo, broadcaster: broadcaster}
+}
+
+func toProto(p models.Product) *pb.Product {
+	return &pb.Product{
+		Id:          int32(p.ID),
+		Name:        p.Name,
+		Description: p.Description,
+		Price:       p.Price,
+		Stock:       int32(p.Stock),
+		Category:    p.Category,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+	}
+}
+
+func mapRepoError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "product not found")
+	}
+	if errors.Is(err, repository.ErrNoFieldsToUpdate) {
+		return status.Error(codes.InvalidArgument, "no fields to update")
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (s *ProductServer) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductResponse, error) {
+	product, err := s.repo.Get(ctx, int(req.Id))
+	if err != nil {
+		return nil, mapRepoError(err)
+	}
+	return &pb.GetProductResponse{Product: toProto(*product)}, nil
+}
+
+func (s *ProductServer) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+
+	products, total, err := s.repo.List(ctx, models.ProductListParams{
+		Limit:      limit,
+		Offset:     int(req.Offset),
+		SortColumn: "created_at",
+		SortOrder:  "desc",
+	})
+	if err != nil {
+		return nil, mapRepoError(err)
+	}
+
+	resp := &pb.ListProductsResponse{Total: int32(total)}
+	for _, p := range products {
+		resp.Products = append(resp.Products, toProto(p))
+	}
+	return resp, nil
+}
+
+func (s *ProductServer) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
+	product, err := s.repo.Create(ctx, models.CreateProductRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		Stock:       int(req.Stock),
+		Category:    req.Category,
+	})
+	if err != nil {
+		return nil, mapRepoError(err)
+	}
+	return &pb.CreateProductResponse{Product: toProto(*product)}, nil
+}
+
+func (s *ProductServer) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
+	update := models.UpdateProductRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		Category:    req.Category,
+	}
+	if req.Stock != nil {
+		stock := int(*req.Stock)
+		update.Stock = &stock
+	}
+
+	product, err := s.repo.Update(ctx, int(req.Id), update)
+	if err != nil {
+		return nil, mapRepoError(err)
+	}
+	return &pb.UpdateProductResponse{Product: toProto(*product)}, nil
+}
+
+func (s *ProductServer) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	if err := s.repo.Delete(ctx, int(req.Id)); err != nil {
+		return nil, mapRepoError(err)
+	}
+	return &pb.DeleteProductResponse{}, nil
+}
+
+func (s *ProductServer) WatchProducts(req *pb.WatchProductsRequest, stream pb.ProductService_WatchProductsServer) error {
+	ch, unsubscribe := s.broadcaster.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			err := stream.Send(&pb.WatchProductsResponse{
+				EventType:  event.EventType,
+				Product:    toProto(event.Product),
+				OccurredAt: timestamppb.New(event.OccurredAt),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// Product is a row in the products table. Version is an optimistic
+// concurrency token that increments on every update.
+type Product struct {
+	ID          int       `json:"id" db:"id"`
+	Name        string    `json:"name" db:"name"`
+	Description string    `json:"description" db:"description"`
+	Price       float64   `json:"price" db:"price"`
+	Stock       int       `json:"stock" db:"stock"`
+	Category    string    `json:"category" db:"category"`
+	Version     int       `json:"version" db:"version"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CreateProductRequest is the payload for POST /api/products.
+type CreateProductRequest struct {
+	Name        string  `json:"name" binding:"required"`
+	Description string  `json:"description" binding:"required"`
+	Price       float64 `json:"price" binding:"required,gt=0"`
+	Stock       int     `json:"stock" binding:"required,gte=0"`
+	Category    string  `json:"category" binding:"required"`
+}
+
+// UpdateProductRequest is the payload for PUT /api/products/:id. Fields are
+// pointers so a nil field means "leave unchanged", distinguishing omission
+// from a legitimate zero value such as a stock of 0.
+type UpdateProductRequest struct {
+	Name        *string  `json:"name" binding:"omitempty"`
+	Description *string  `json:"description" binding:"omitempty"`
+	Price       *float64 `json:"price" binding:"omitempty,gt=0"`
+	Stock       *int     `json:"stock" binding:"omitempty,gte=0"`
+	Category    *string  `json:"category" binding:"omitempty"`
+}
+
+// ProductListParams carries the filtering, sorting, and pagination options
+// for GET /api/products, already validated and defaulted by the caller.
+type ProductListParams struct {
+	Limit      int
+	Offset     int
+	SortColumn string
+	SortOrder  string
+	Category   string
+	MinPrice   *float64
+	MaxPrice   *float64
+	InStock    *bool
+	Query      string
+}
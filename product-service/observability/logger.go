@@ -0,0 +1,54 @@
+// Package observability wires structured logging, Prometheus metrics, and
+// OpenTelemetry tracing for the product service.
+package observability
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"product-service/auth"
+)
+
+func init() {
+	zerolog.TimeFieldFormat = time.RFC3339
+}
+
+// RequestLogger replaces gin.Logger() with structured JSON request logs
+// carrying request_id, method, path, status, latency_ms, and user_id.
+func RequestLogger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader("X-Request-Id")
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		c.Set("request_id", requestID)
+
+		start := time.Now()
+		c.Next()
+
+		var userID int
+		if value, exists := c.Get(auth.ClaimsContextKey); exists {
+			if claims, ok := value.(*auth.Claims); ok {
+				userID = claims.UserID
+			}
+		}
+
+		event := log.Info()
+		if len(c.Errors) > 0 {
+			event = log.Error()
+		}
+
+		event.
+			Str("request_id", requestID).
+			Str("method", c.Request.Method).
+			Str("path", c.FullPath()).
+			Int("status", c.Writer.Status()).
+			Float64("latency_ms", float64(time.Since(start).Microseconds())/1000).
+			Int("user_id", userID).
+			Msg("request completed")
+	}
+}
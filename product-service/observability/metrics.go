@@ -0,0 +1,65 @@
+package observability
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total HTTP requests processed, labeled by method, path, and status.",
+	}, []string{"method", "path", "status"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency in seconds.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "path", "status"})
+
+	productsCreatedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "products_created_total",
+		Help: "Total products created.",
+	})
+
+	dbQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "Database query latency in seconds, labeled by query name.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"query"})
+)
+
+// Metrics records http_requests_total and http_request_duration_seconds for
+// every request.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := strconv.Itoa(c.Writer.Status())
+		path := c.FullPath()
+		httpRequestsTotal.WithLabelValues(c.Request.Method, path, status).Inc()
+		httpRequestDuration.WithLabelValues(c.Request.Method, path, status).Observe(time.Since(start).Seconds())
+	}
+}
+
+// Handler exposes the Prometheus scrape endpoint.
+func Handler() gin.HandlerFunc {
+	return gin.WrapH(promhttp.Handler())
+}
+
+// IncProductsCreated increments the products_created_total counter.
+func IncProductsCreated() {
+	productsCreatedTotal.Inc()
+}
+
+// ObserveDBQuery records a db_query_duration_seconds observation for the
+// given query name.
+func ObserveDBQuery(query string, duration time.Duration) {
+	dbQueryDuration.WithLabelValues(query).Observe(duration.Seconds())
+}
@@ -0,0 +1,12 @@
+package repository
+
+import "errors"
+
+// ErrNoFieldsToUpdate is returned by ProductRepository.Update when the
+// request did not contain any fields to change.
+var ErrNoFieldsToUpdate = errors.New("no fields to update")
+
+// ErrVersionConflict is returned by ProductRepository.Patch when the
+// expected version does not match the row's current version, i.e. the
+// product was modified concurrently since it was read.
+var ErrVersionConflict = errors.New("version conflict")
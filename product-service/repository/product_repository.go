@@ -0,0 +1,397 @@
+// Package repository contains the data-access layer, isolating controllers
+// from SQL so they can be tested against mock implementations.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"product-service/events"
+	"product-service/models"
+	"product-service/observability"
+)
+
+// ProductRepository is the data-access contract for products. Controllers
+// depend on this interface rather than a concrete database so they can be
+// unit tested with a mock.
+type ProductRepository interface {
+	List(ctx context.Context, params models.ProductListParams) ([]models.Product, int, error)
+	Get(ctx context.Context, id int) (*models.Product, error)
+	Create(ctx context.Context, req models.CreateProductRequest) (*models.Product, error)
+	Update(ctx context.Context, id int, req models.UpdateProductRequest) (*models.Product, error)
+	// Patch applies req with optimistic concurrency, failing with
+	// ErrVersionConflict if the row's version no longer matches
+	// expectedVersion (i.e. it was modified since the caller read it).
+	Patch(ctx context.Context, id int, expectedVersion int, req models.UpdateProductRequest) (*models.Product, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type postgresProductRepository struct {
+	db     *sql.DB
+	outbox events.OutboxRepository
+}
+
+// NewProductRepository returns a ProductRepository backed by Postgres. Each
+// mutation writes its lifecycle event to outbox in the same transaction.
+func NewProductRepository(db *sql.DB, outbox events.OutboxRepository) ProductRepository {
+	return &postgresProductRepository{db: db, outbox: outbox}
+}
+
+func (r *postgresProductRepository) publishEvent(ctx context.Context, tx *sql.Tx, eventType string, product models.Product) error {
+	payload, err := json.Marshal(events.NewProductEvent(eventType, product))
+	if err != nil {
+		return err
+	}
+	return r.outbox.Enqueue(ctx, tx, eventType, payload)
+}
+
+func scanProduct(row interface {
+	Scan(dest ...interface{}) error
+}) (*models.Product, error) {
+	var product models.Product
+	err := row.Scan(
+		&product.ID,
+		&product.Name,
+		&product.Description,
+		&product.Price,
+		&product.Stock,
+		&product.Category,
+		&product.Version,
+		&product.CreatedAt,
+		&product.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+func (r *postgresProductRepository) List(ctx context.Context, params models.ProductListParams) ([]models.Product, int, error) {
+	start := time.Now()
+	defer func() { observability.ObserveDBQuery("products.list", time.Since(start)) }()
+
+	conditions := []string{}
+	args := []interface{}{}
+	argIndex := 1
+
+	addCondition := func(cond string, val interface{}) {
+		conditions = append(conditions, fmt.Sprintf(cond, argIndex))
+		args = append(args, val)
+		argIndex++
+	}
+
+	if params.Category != "" {
+		addCondition("category = $%d", params.Category)
+	}
+	if params.MinPrice != nil {
+		addCondition("price >= $%d", *params.MinPrice)
+	}
+	if params.MaxPrice != nil {
+		addCondition("price <= $%d", *params.MaxPrice)
+	}
+	if params.InStock != nil {
+		if *params.InStock {
+			conditions = append(conditions, "stock > 0")
+		} else {
+			conditions = append(conditions, "stock = 0")
+		}
+	}
+
+	queryArgIndex := 0
+	if params.Query != "" {
+		queryArgIndex = argIndex
+		addCondition("to_tsvector('simple', name || ' ' || description) @@ plainto_tsquery('simple', $%d)", params.Query)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM products %s", where)
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	orderBy := fmt.Sprintf("%s %s", params.SortColumn, params.SortOrder)
+	if queryArgIndex > 0 {
+		orderBy = fmt.Sprintf(
+			"ts_rank(to_tsvector('simple', name || ' ' || description), plainto_tsquery('simple', $%d)) DESC, %s",
+			queryArgIndex, orderBy,
+		)
+	}
+
+	limitIndex := argIndex
+	offsetIndex := argIndex + 1
+	listArgs := append(append([]interface{}{}, args...), params.Limit, params.Offset)
+
+	listQuery := fmt.Sprintf(`
+		SELECT id, name, description, price, stock, category, version, created_at, updated_at
+		FROM products
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, where, orderBy, limitIndex, offsetIndex)
+
+	rows, err := r.db.QueryContext(ctx, listQuery, listArgs...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	products := []models.Product{}
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		products = append(products, *product)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return products, total, nil
+}
+
+func (r *postgresProductRepository) Get(ctx context.Context, id int) (*models.Product, error) {
+	start := time.Now()
+	defer func() { observability.ObserveDBQuery("products.get", time.Since(start)) }()
+
+	query := `
+		SELECT id, name, description, price, stock, category, version, created_at, updated_at
+		FROM products
+		WHERE id = $1
+	`
+	return scanProduct(r.db.QueryRowContext(ctx, query, id))
+}
+
+func (r *postgresProductRepository) Create(ctx context.Context, req models.CreateProductRequest) (*models.Product, error) {
+	start := time.Now()
+	defer func() { observability.ObserveDBQuery("products.create", time.Since(start)) }()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO products (name, description, price, stock, category, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		RETURNING id, name, description, price, stock, category, version, created_at, updated_at
+	`
+	product, err := scanProduct(tx.QueryRowContext(ctx, query, req.Name, req.Description, req.Price, req.Stock, req.Category))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.publishEvent(ctx, tx, events.ProductCreated, *product); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+func (r *postgresProductRepository) Update(ctx context.Context, id int, req models.UpdateProductRequest) (*models.Product, error) {
+	start := time.Now()
+	defer func() { observability.ObserveDBQuery("products.update", time.Since(start)) }()
+
+	updates, args, argIndex := buildProductUpdates(req)
+	if len(updates) == 0 {
+		return nil, ErrNoFieldsToUpdate
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var previousStock int
+	if err := tx.QueryRowContext(ctx, "SELECT stock FROM products WHERE id = $1", id).Scan(&previousStock); err != nil {
+		return nil, err
+	}
+
+	updates = append(updates, "version = version + 1", "updated_at = NOW()")
+	args = append(args, id)
+
+	query := fmt.Sprintf(`
+		UPDATE products
+		SET %s
+		WHERE id = $%d
+		RETURNING id, name, description, price, stock, category, version, created_at, updated_at
+	`, strings.Join(updates, ", "), argIndex)
+
+	product, err := scanProduct(tx.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.publishEvent(ctx, tx, events.ProductUpdated, *product); err != nil {
+		return nil, err
+	}
+
+	if product.Stock != previousStock {
+		if err := r.publishEvent(ctx, tx, events.ProductStockChanged, *product); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+// buildProductUpdates turns the non-nil fields of req into a list of SQL
+// "column = $n" assignments and their bind args, starting at bind index 1.
+// It returns the next free bind index alongside the assignments.
+func buildProductUpdates(req models.UpdateProductRequest) ([]string, []interface{}, int) {
+	updates := []string{}
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.Name != nil {
+		updates = append(updates, fmt.Sprintf("name = $%d", argIndex))
+		args = append(args, *req.Name)
+		argIndex++
+	}
+	if req.Description != nil {
+		updates = append(updates, fmt.Sprintf("description = $%d", argIndex))
+		args = append(args, *req.Description)
+		argIndex++
+	}
+	if req.Price != nil {
+		updates = append(updates, fmt.Sprintf("price = $%d", argIndex))
+		args = append(args, *req.Price)
+		argIndex++
+	}
+	if req.Stock != nil {
+		updates = append(updates, fmt.Sprintf("stock = $%d", argIndex))
+		args = append(args, *req.Stock)
+		argIndex++
+	}
+	if req.Category != nil {
+		updates = append(updates, fmt.Sprintf("category = $%d", argIndex))
+		args = append(args, *req.Category)
+		argIndex++
+	}
+
+	return updates, args, argIndex
+}
+
+// Patch applies req to the product identified by id, but only if its
+// current version still matches expectedVersion. This guards against lost
+// updates from a PATCH racing a concurrent write: the caller reads the
+// product (capturing its version), builds req from a JSON merge/patch
+// document, and Patch fails with ErrVersionConflict if the row moved on.
+func (r *postgresProductRepository) Patch(ctx context.Context, id int, expectedVersion int, req models.UpdateProductRequest) (*models.Product, error) {
+	start := time.Now()
+	defer func() { observability.ObserveDBQuery("products.patch", time.Since(start)) }()
+
+	updates, args, argIndex := buildProductUpdates(req)
+	if len(updates) == 0 {
+		return nil, ErrNoFieldsToUpdate
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var previousStock int
+	if err := tx.QueryRowContext(ctx, "SELECT stock FROM products WHERE id = $1", id).Scan(&previousStock); err != nil {
+		return nil, err
+	}
+
+	updates = append(updates, "version = version + 1", "updated_at = NOW()")
+	idIndex := argIndex
+	versionIndex := argIndex + 1
+	args = append(args, id, expectedVersion)
+
+	query := fmt.Sprintf(`
+		UPDATE products
+		SET %s
+		WHERE id = $%d AND version = $%d
+		RETURNING id, name, description, price, stock, category, version, created_at, updated_at
+	`, strings.Join(updates, ", "), idIndex, versionIndex)
+
+	product, err := scanProduct(tx.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		var exists bool
+		if checkErr := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM products WHERE id = $1)", id).Scan(&exists); checkErr != nil {
+			return nil, checkErr
+		}
+		if exists {
+			return nil, ErrVersionConflict
+		}
+		return nil, sql.ErrNoRows
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := r.publishEvent(ctx, tx, events.ProductUpdated, *product); err != nil {
+		return nil, err
+	}
+
+	if product.Stock != previousStock {
+		if err := r.publishEvent(ctx, tx, events.ProductStockChanged, *product); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+func (r *postgresProductRepository) Delete(ctx context.Context, id int) error {
+	start := time.Now()
+	defer func() { observability.ObserveDBQuery("products.delete", time.Since(start)) }()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	product, err := scanProduct(tx.QueryRowContext(ctx, `
+		SELECT id, name, description, price, stock, category, version, created_at, updated_at
+		FROM products
+		WHERE id = $1
+	`, id))
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM products WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	if err := r.publishEvent(ctx, tx, events.ProductDeleted, *product); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"product-service/models"
+)
+
+// UserRepository is the data-access contract for users.
+type UserRepository interface {
+	Create(ctx context.Context, username, passwordHash string) (*models.User, error)
+	GetByUsername(ctx context.Context, username string) (*models.User, error)
+	EnsureAdmin(ctx context.Context, username, passwordHash string) error
+}
+
+type postgresUserRepository struct {
+	db *sql.DB
+}
+
+// NewUserRepository returns a UserRepository backed by Postgres.
+func NewUserRepository(db *sql.DB) UserRepository {
+	return &postgresUserRepository{db: db}
+}
+
+func (r *postgresUserRepository) Create(ctx context.Context, username, passwordHash string) (*models.User, error) {
+	query := `
+		INSERT INTO users (username, password_hash, role)
+		VALUES ($1, $2, 'customer')
+		RETURNING id, username, role, created_at
+	`
+
+	var user models.User
+	err := r.db.QueryRowContext(ctx, query, username, passwordHash).Scan(&user.ID, &user.Username, &user.Role, &user.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// EnsureAdmin creates an admin user with the given credentials if no user
+// with that username exists yet. It is idempotent so it can run on every
+// startup: once the admin account exists, later calls are no-ops and never
+// touch its password or role.
+func (r *postgresUserRepository) EnsureAdmin(ctx context.Context, username, passwordHash string) error {
+	query := `
+		INSERT INTO users (username, password_hash, role)
+		VALUES ($1, $2, 'admin')
+		ON CONFLICT (username) DO NOTHING
+	`
+
+	_, err := r.db.ExecContext(ctx, query, username, passwordHash)
+	return err
+}
+
+func (r *postgresUserRepository) GetByUsername(ctx context.Context, username string) (*models.User, error) {
+	query := `SELECT id, username, password_hash, role, created_at FROM users WHERE username = $1`
+
+	var user models.User
+	err := r.db.QueryRowContext(ctx, query, username).Scan(&user.ID, &user.Username, &user.PasswordHash, &user.Role, &user.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
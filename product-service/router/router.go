@@ -0,0 +1,70 @@
+// Package router assembles the Gin engine: middleware, CORS, and route
+// wiring to the controllers.
+package router
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+
+	"product-service/auth"
+	"product-service/controllers"
+	"product-service/observability"
+)
+
+// New builds the Gin engine for the product service.
+func New(productController *controllers.ProductController, authController *controllers.AuthController) *gin.Engine {
+	router := gin.New()
+
+	router.Use(gin.Recovery())
+	router.Use(otelgin.Middleware("product-service"))
+	router.Use(observability.RequestLogger())
+	router.Use(observability.Metrics())
+	router.Use(cors())
+
+	router.GET("/health", healthCheck)
+	router.GET("/metrics", observability.Handler())
+
+	authGroup := router.Group("/api/auth")
+	{
+		authGroup.POST("/register", authController.Register)
+		authGroup.POST("/login", authController.Login)
+	}
+
+	api := router.Group("/api/products")
+	{
+		api.GET("", productController.List)
+		api.GET("/:id", productController.Get)
+		api.POST("", auth.RequireAuth(), auth.RequireRole("admin"), productController.Create)
+		api.PUT("/:id", auth.RequireAuth(), auth.RequireRole("admin"), productController.Update)
+		api.PATCH("/:id", auth.RequireAuth(), auth.RequireRole("admin"), productController.Patch)
+		api.DELETE("/:id", auth.RequireAuth(), auth.RequireRole("admin"), productController.Delete)
+	}
+
+	return router
+}
+
+func cors() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Access-Control-Allow-Origin", "*")
+		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")
+
+		if c.Request.Method == "OPTIONS" {
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func healthCheck(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status":    "OK",
+		"service":   "Product Service",
+		"timestamp": time.Now().Format(time.RFC3339),
+	})
+}